@@ -11,9 +11,14 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 )
 
+// APIKeysURL is the dashboard page where users create and revoke CLI keys.
+// Always the production origin, even when the API base points elsewhere.
+const APIKeysURL = "https://brew.ops0.ai/settings?tab=api-keys"
+
 // Client wraps an authenticated *http.Client pinned to one ops0 instance.
 type Client struct {
 	BaseURL string
@@ -84,7 +89,27 @@ type APIError struct {
 }
 
 func (e *APIError) Error() string {
-	return fmt.Sprintf("ops0 API %s returned %d: %s", e.Path, e.Status, e.Body)
+	// Bodies are usually JSON or plain text with a trailing newline; trim so
+	// the message doesn't break mid-line.
+	body := strings.TrimSpace(e.Body)
+	switch e.Status {
+	case http.StatusUnauthorized:
+		// The raw body ("invalid api key") doesn't tell the user how to
+		// recover, so lead with the fix and keep the server's reason.
+		return fmt.Sprintf("ops0 API %s returned %d: your ops0 API key appears invalid, expired or revoked — generate a new one at %s and run `ops0 login` (%s)", e.Path, e.Status, APIKeysURL, body)
+	case http.StatusForbidden:
+		// 403 can be a valid key that lacks a scope or project access; only
+		// the body says which, so it has to reach the user.
+		return fmt.Sprintf("ops0 API %s returned %d: your ops0 API key was refused for this request — check its scopes and project access in ops0 settings: %s", e.Path, e.Status, body)
+	}
+	return fmt.Sprintf("ops0 API %s returned %d: %s", e.Path, e.Status, body)
+}
+
+// Unauthorized reports whether the server rejected the API key itself (401).
+// A 403 is deliberately excluded: the key authenticated but lacks a scope or
+// project access, so replacing it isn't the fix.
+func (e *APIError) Unauthorized() bool {
+	return e.Status == http.StatusUnauthorized
 }
 
 // Whoami verifies the API key is valid and returns the org/user it's bound to.
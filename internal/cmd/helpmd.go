@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// `ops0 --help-markdown` emits the whole command reference as Markdown for
+// teams that mirror it into an internal wiki. It walks the same cobra tree
+// (Short / Long / Use / flags) that `--help` renders, so the two can't
+// drift. We hand-roll this instead of pulling in cobra/doc, which drags in
+// go-md2man for the man-page half we don't need.
+
+var helpMarkdown bool
+
+func init() {
+	rootCmd.Flags().BoolVar(&helpMarkdown, "help-markdown", false, "Print the full command reference as Markdown and exit")
+	// Root stays non-runnable so `ops0 --help` keeps its usage line. Cobra
+	// routes a non-runnable command straight to its help func (skipping
+	// every PreRun hook), so that's where we intercept the flag. The func
+	// is inherited by subcommands, hence the rootCmd check.
+	defaultHelp := rootCmd.HelpFunc()
+	rootCmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
+		if cmd == rootCmd && helpMarkdown {
+			writeHelpMarkdown(cmd.OutOrStdout(), cmd)
+			return
+		}
+		defaultHelp(cmd, args)
+	})
+}
+
+// writeHelpMarkdown renders c and, depth-first, every visible subcommand.
+// Heading depth follows nesting: `ops0` is h1, `ops0 policies` h2, and so on.
+func writeHelpMarkdown(w io.Writer, c *cobra.Command) {
+	depth := strings.Count(c.CommandPath(), " ") + 1
+	if depth > 6 {
+		depth = 6
+	}
+	fmt.Fprintf(w, "%s `%s`\n\n", strings.Repeat("#", depth), c.CommandPath())
+	if c.Short != "" {
+		fmt.Fprintf(w, "%s\n\n", c.Short)
+	}
+	if c.Runnable() {
+		fmt.Fprintf(w, "```\n%s\n```\n\n", c.UseLine())
+	}
+	if c.Long != "" {
+		// Long help is hand-wrapped with indented examples; a plain code
+		// fence preserves that layout exactly.
+		fmt.Fprintf(w, "```text\n%s\n```\n\n", strings.TrimSpace(c.Long))
+	}
+
+	var rows []string
+	c.NonInheritedFlags().VisitAll(func(f *pflag.Flag) {
+		if f.Hidden || f.Name == "help" || f.Name == "help-markdown" {
+			return
+		}
+		name := "`--" + f.Name + "`"
+		if f.Shorthand != "" {
+			name = "`-" + f.Shorthand + "`, " + name
+		}
+		def := f.DefValue
+		if def == "" {
+			def = `""`
+		}
+		rows = append(rows, fmt.Sprintf("| %s | `%s` | %s |", name, def, escapeTableCell(f.Usage)))
+	})
+	if len(rows) > 0 {
+		fmt.Fprintln(w, "| Flag | Default | Purpose |\n|---|---|---|")
+		fmt.Fprintf(w, "%s\n\n", strings.Join(rows, "\n"))
+	}
+
+	subs := make([]*cobra.Command, 0, len(c.Commands()))
+	for _, sub := range c.Commands() {
+		if sub.IsAvailableCommand() && !sub.IsAdditionalHelpTopicCommand() {
+			subs = append(subs, sub)
+		}
+	}
+	if len(subs) > 0 {
+		fmt.Fprintln(w, "| Command | What it does |\n|---|---|")
+		for _, sub := range subs {
+			fmt.Fprintf(w, "| `%s` | %s |\n", sub.CommandPath(), escapeTableCell(sub.Short))
+		}
+		fmt.Fprintln(w)
+	}
+	for _, sub := range subs {
+		writeHelpMarkdown(w, sub)
+	}
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// runRoot executes rootCmd with args and returns what it wrote to stdout.
+// Flag values stick to the package-level command between Execute calls, so
+// the cleanup resets the ones these tests set.
+func runRoot(t *testing.T, args ...string) string {
+	t.Helper()
+	var out bytes.Buffer
+	rootCmd.SetOut(&out)
+	rootCmd.SetArgs(args)
+	t.Cleanup(func() {
+		rootCmd.SetOut(nil)
+		rootCmd.SetArgs(nil)
+		helpMarkdown = false
+		if f := rootCmd.Flags().Lookup("help"); f != nil {
+			_ = f.Value.Set("false")
+		}
+	})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("ops0 %s: %v", strings.Join(args, " "), err)
+	}
+	return out.String()
+}
+
+func TestHelpMarkdown(t *testing.T) {
+	out := runRoot(t, "--help-markdown")
+	for _, want := range []string{
+		"# `ops0`\n",
+		"## `ops0 policies`\n",
+		"### `ops0 policies check`\n",
+		"| `--fail-on` |",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("markdown output is missing %q", want)
+		}
+	}
+	if strings.Contains(out, "--help-markdown") {
+		t.Error("markdown output should not list --help-markdown itself")
+	}
+}
+
+func TestHelpUnchangedByHelpMarkdown(t *testing.T) {
+	out := runRoot(t, "--help")
+	if strings.Contains(out, "# `ops0`") {
+		t.Fatal("--help rendered Markdown")
+	}
+	if !strings.Contains(out, "Usage:\n  ops0 [command]\n") {
+		t.Errorf("--help usage section changed:\n%s", out)
+	}
+	// Root must stay non-runnable, or cobra adds an `ops0 [flags]` line.
+	if strings.Contains(out, "ops0 [flags]") {
+		t.Error("--help lists `ops0 [flags]`; root became runnable")
+	}
+}
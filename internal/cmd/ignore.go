@@ -0,0 +1,181 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// .ops0ignore — gitignore-style excludes for the IaC bundle.
+//
+// collectIacFiles already skips the usual heavy dirs (.git, .terraform,
+// node_modules, …). Monorepos have their own junk on top of that: vendored
+// modules, generated fixtures, example stacks that are never applied. Listing
+// them in .ops0ignore keeps them out of the upload so they neither eat into
+// the payload cap nor produce findings nobody will act on.
+//
+// Supported syntax is the useful subset of gitignore:
+//
+//	# comment           blank lines and comments are skipped
+//	examples/           trailing slash: directories only
+//	/legacy             leading slash: anchored to the .ops0ignore's directory
+//	modules/*/test      a slash anywhere: anchored, `*` stays within one segment
+//	**/fixtures         `**` spans any number of segments
+//	*.generated.tf      no slash: matches the name at any depth
+//	!keep.tf            negation: re-include something an earlier line excluded
+//
+// Later lines win over earlier ones, as in git.
+
+const ignoreFileName = ".ops0ignore"
+
+type ignorePattern struct {
+	re      *regexp.Regexp
+	negate  bool
+	dirOnly bool
+}
+
+// ignoreRules is one parsed .ops0ignore. Patterns are relative to base.
+type ignoreRules struct {
+	base     string
+	patterns []ignorePattern
+}
+
+// loadIgnoreFile parses <dir>/.ops0ignore. Returns (nil, nil) when the file
+// doesn't exist so callers can treat "no ignore file" as "no rules".
+func loadIgnoreFile(dir string) (*ignoreRules, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	path := filepath.Join(dir, ignoreFileName)
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	rules := &ignoreRules{base: dir}
+	sc := bufio.NewScanner(f)
+	lineNo := 0
+	for sc.Scan() {
+		lineNo++
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		raw := line
+		p := ignorePattern{}
+		if strings.HasPrefix(line, "!") {
+			p.negate = true
+			line = line[1:]
+		}
+		if strings.HasSuffix(line, "/") {
+			p.dirOnly = true
+			line = strings.TrimRight(line, "/")
+		}
+		if line == "" {
+			continue
+		}
+		// gitignore: a slash at the start or in the middle anchors the
+		// pattern to the ignore file's directory; otherwise it matches the
+		// name at any depth.
+		anchored := strings.Contains(line, "/")
+		line = strings.TrimPrefix(line, "/")
+		expr := globToRegexp(line)
+		if !anchored {
+			expr = "(?:.*/)?" + expr
+		}
+		re, err := regexp.Compile("^" + expr + "$")
+		if err != nil {
+			// Fail loudly: silently dropping the line would upload files
+			// the user explicitly asked us to leave out.
+			return nil, fmt.Errorf("%s:%d: invalid pattern %q: %v", path, lineNo, raw, err)
+		}
+		p.re = re
+		rules.patterns = append(rules.patterns, p)
+	}
+	return rules, sc.Err()
+}
+
+// ignored reports whether path (absolute, or relative to the process cwd)
+// is excluded by these rules. Paths outside base are never ignored.
+func (r *ignoreRules) ignored(path string, isDir bool) bool {
+	if r == nil {
+		return false
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(r.base, abs)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return false
+	}
+	rel = filepath.ToSlash(rel)
+	out := false
+	for _, p := range r.patterns {
+		if p.dirOnly && !isDir {
+			continue
+		}
+		if p.re.MatchString(rel) {
+			out = !p.negate
+		}
+	}
+	return out
+}
+
+// globToRegexp translates one gitignore glob into a regexp body.
+func globToRegexp(glob string) string {
+	var sb strings.Builder
+	for i := 0; i < len(glob); i++ {
+		c := glob[i]
+		switch c {
+		case '*':
+			if i+1 < len(glob) && glob[i+1] == '*' {
+				i++
+				// "**/" matches zero or more whole segments.
+				if i+1 < len(glob) && glob[i+1] == '/' {
+					i++
+					sb.WriteString("(?:.*/)?")
+				} else {
+					sb.WriteString(".*")
+				}
+				continue
+			}
+			sb.WriteString("[^/]*")
+		case '?':
+			sb.WriteString("[^/]")
+		case '[':
+			// As in gitignore, a `]` right after `[` or `[!` is a literal
+			// member of the class, not its end.
+			start := i + 1
+			if start < len(glob) && glob[start] == '!' {
+				start++
+			}
+			if start < len(glob) && glob[start] == ']' {
+				start++
+			}
+			if j := strings.IndexByte(glob[start:], ']'); j >= 0 {
+				class := glob[i+1 : start+j]
+				if strings.HasPrefix(class, "!") {
+					class = "^" + class[1:]
+				}
+				class = strings.Replace(class, "]", `\]`, 1)
+				sb.WriteString("[" + class + "]")
+				i = start + j
+				continue
+			}
+			sb.WriteString(`\[`)
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	return sb.String()
+}
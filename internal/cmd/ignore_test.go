@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeIgnoreFile(t *testing.T, dir string, lines ...string) {
+	t.Helper()
+	data := strings.Join(lines, "\n") + "\n"
+	if err := os.WriteFile(filepath.Join(dir, ignoreFileName), []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIgnoreRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern []string
+		path    string
+		isDir   bool
+		want    bool
+	}{
+		// Unanchored: no slash matches the name at any depth.
+		{"name at root", []string{"*.generated.tf"}, "a.generated.tf", false, true},
+		{"name nested", []string{"*.generated.tf"}, "mod/x/a.generated.tf", false, true},
+		{"name no match", []string{"*.generated.tf"}, "mod/main.tf", false, false},
+		{"star stays in segment", []string{"*.tf"}, "mod/main.tf", false, true},
+
+		// Anchored: a leading or middle slash ties the pattern to base.
+		{"leading slash root", []string{"/legacy"}, "legacy", true, true},
+		{"leading slash nested", []string{"/legacy"}, "mod/legacy", true, false},
+		{"middle slash", []string{"modules/*/test"}, "modules/vpc/test", true, true},
+		{"middle slash too deep", []string{"modules/*/test"}, "modules/vpc/sub/test", true, false},
+		{"middle slash not at root", []string{"modules/*/test"}, "live/modules/vpc/test", true, false},
+
+		// Double star spans segments.
+		{"leading doublestar root", []string{"**/fixtures"}, "fixtures", true, true},
+		{"leading doublestar deep", []string{"**/fixtures"}, "a/b/c/fixtures", true, true},
+		{"trailing doublestar", []string{"vendor/**"}, "vendor/a/b.tf", false, true},
+		{"middle doublestar", []string{"live/**/prod.tfvars"}, "live/us/east/prod.tfvars", false, true},
+		{"middle doublestar zero segments", []string{"live/**/prod.tfvars"}, "live/prod.tfvars", false, true},
+
+		// Dir-only patterns never match files.
+		{"dir only matches dir", []string{"examples/"}, "examples", true, true},
+		{"dir only skips file", []string{"examples/"}, "examples", false, false},
+		{"dir only nested", []string{"examples/"}, "mod/examples", true, true},
+
+		// Negation: later lines win.
+		{"negation re-includes", []string{"*.generated.tf", "!keep.generated.tf"}, "keep.generated.tf", false, false},
+		{"negation leaves others", []string{"*.generated.tf", "!keep.generated.tf"}, "drop.generated.tf", false, true},
+		{"later exclude wins", []string{"!keep.tf", "*.tf"}, "keep.tf", false, true},
+
+		// Classes and single-char wildcards.
+		{"question mark", []string{"env?.tfvars"}, "env1.tfvars", false, true},
+		{"question mark no slash", []string{"a?b"}, "a/b", false, false},
+		{"class", []string{"env[12].tfvars"}, "env2.tfvars", false, true},
+		{"negated class", []string{"env[!12].tfvars"}, "env3.tfvars", false, true},
+		{"leading bracket literal", []string{"[]x].tf"}, "].tf", false, true},
+
+		// Comments and blanks are skipped; dots are literal.
+		{"comment ignored", []string{"# main.tf", "", "other.tf"}, "main.tf", false, false},
+		{"dot is literal", []string{"a.tf"}, "abtf", false, false},
+		{"leading double dot name", []string{"..generated.tf"}, "..generated.tf", false, true},
+
+		// The base itself is never ignored.
+		{"base itself", []string{"*"}, ".", true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeIgnoreFile(t, dir, tt.pattern...)
+			rules, err := loadIgnoreFile(dir)
+			if err != nil {
+				t.Fatalf("loadIgnoreFile: %v", err)
+			}
+			got := rules.ignored(filepath.Join(dir, filepath.FromSlash(tt.path)), tt.isDir)
+			if got != tt.want {
+				t.Errorf("patterns %q, path %q (dir=%v): got %v, want %v", tt.pattern, tt.path, tt.isDir, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIgnoreRulesOutsideBase(t *testing.T) {
+	dir := t.TempDir()
+	writeIgnoreFile(t, dir, "*")
+	rules, err := loadIgnoreFile(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rules.ignored(filepath.Join(filepath.Dir(dir), "main.tf"), false) {
+		t.Error("path outside base should never be ignored")
+	}
+}
+
+func TestLoadIgnoreFileMissing(t *testing.T) {
+	rules, err := loadIgnoreFile(t.TempDir())
+	if err != nil || rules != nil {
+		t.Fatalf("got (%v, %v), want (nil, nil)", rules, err)
+	}
+	// nil rules are safe to query.
+	if rules.ignored("main.tf", false) {
+		t.Error("nil rules should ignore nothing")
+	}
+}
+
+func TestLoadIgnoreFileInvalidPattern(t *testing.T) {
+	dir := t.TempDir()
+	writeIgnoreFile(t, dir, "# fine", "*.tf", "[z-a].tf")
+	_, err := loadIgnoreFile(dir)
+	if err == nil {
+		t.Fatal("expected an error for an invalid character class")
+	}
+	want := filepath.Join(dir, ignoreFileName) + ":3:"
+	if !strings.Contains(err.Error(), want) {
+		t.Errorf("error %q should name %q", err, want)
+	}
+}
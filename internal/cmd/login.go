@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -44,16 +45,18 @@ func runLogin(cmd *cobra.Command, _ []string) error {
 	}
 
 	// Resolve API key: flag > env > prompt. We intentionally don't echo it.
-	key := loginAPIKey
+	// Trim everything: keys often arrive via `$(cat keyfile)` or a secrets
+	// mount with a trailing newline, which the server rejects as invalid.
+	key := strings.TrimSpace(loginAPIKey)
 	if key == "" {
-		key = os.Getenv("OPS0_API_KEY")
+		key = strings.TrimSpace(os.Getenv("OPS0_API_KEY"))
 	}
 	if key == "" {
 		// Always point users at the production dashboard URL even when their
 		// CLI is configured against localhost — the API base and the dashboard
 		// origin can differ in dev, and the friendly URL is the recoverable
 		// one regardless of which mode you're in.
-		fmt.Fprintln(cmd.OutOrStdout(), "Paste your ops0 API key (get one at https://brew.ops0.ai/settings?tab=api-keys):")
+		fmt.Fprintln(cmd.OutOrStdout(), "Paste your ops0 API key (get one at "+api.APIKeysURL+"):")
 		// We use a regular bufio reader rather than golang.org/x/term so this
 		// builds with no extra deps on Windows. Trade-off: key is visible
 		// while typing. Most users will paste, not type, so it's acceptable.
@@ -74,6 +77,13 @@ func runLogin(cmd *cobra.Command, _ []string) error {
 	client := api.New(cfg.APIBaseURL, cfg.APIKey)
 	who, err := client.Whoami()
 	if err != nil {
+		// The generic 401 wording says "run `ops0 login`", which is what
+		// the user is already doing — say the key was rejected instead. A
+		// 403 keeps its scope/project-access wording, which still applies.
+		var apiErr *api.APIError
+		if errors.As(err, &apiErr) && apiErr.Unauthorized() {
+			return fmt.Errorf("verifying key: key rejected (%d: %s)", apiErr.Status, strings.TrimSpace(apiErr.Body))
+		}
 		return fmt.Errorf("verifying key: %w", err)
 	}
 
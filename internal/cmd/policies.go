@@ -134,6 +134,34 @@ func collectIacFiles(root string) ([]api.CheckFile, error) {
 		".git": {}, ".terraform": {}, "node_modules": {}, ".idea": {}, ".vscode": {},
 	}
 
+	// User excludes: .ops0ignore at the scan root, plus the one next to the
+	// bound project's .ops0/ when scanning a subdirectory of it. An explicit
+	// single-file target above bypasses these on purpose.
+	var ignores []*ignoreRules
+	dirs := []string{root}
+	if _, repoRoot, _ := config.FindRepo(root); repoRoot != "" {
+		if abs, err := filepath.Abs(root); err != nil || abs != repoRoot {
+			dirs = append(dirs, repoRoot)
+		}
+	}
+	for _, dir := range dirs {
+		rules, err := loadIgnoreFile(dir)
+		if err != nil {
+			return nil, err
+		}
+		if rules != nil {
+			ignores = append(ignores, rules)
+		}
+	}
+	isIgnored := func(path string, isDir bool) bool {
+		for _, r := range ignores {
+			if r.ignored(path, isDir) {
+				return true
+			}
+		}
+		return false
+	}
+
 	var files []api.CheckFile
 	const maxBytes = 4 * 1024 * 1024 // server caps at 5MB; leave headroom
 	var total int
@@ -146,6 +174,9 @@ func collectIacFiles(root string) ([]api.CheckFile, error) {
 			if _, skip := skipDirs[d.Name()]; skip {
 				return fs.SkipDir
 			}
+			if path != root && isIgnored(path, true) {
+				return fs.SkipDir
+			}
 			return nil
 		}
 		name := strings.ToLower(d.Name())
@@ -160,6 +191,9 @@ func collectIacFiles(root string) ([]api.CheckFile, error) {
 			strings.HasSuffix(name, ".tfvars.json")) {
 			return nil
 		}
+		if isIgnored(path, false) {
+			return nil
+		}
 		body, err := os.ReadFile(path)
 		if err != nil {
 			return err
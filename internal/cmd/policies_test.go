@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/ops0-ai/ops0-cli/internal/config"
+)
+
+func TestCollectIacFilesIgnores(t *testing.T) {
+	repo := t.TempDir()
+	if err := config.SaveRepo(repo, &config.RepoConfig{ProjectID: "p1"}); err != nil {
+		t.Fatal(err)
+	}
+	writeIgnoreFile(t, repo, "examples/")
+	live := filepath.Join(repo, "live")
+	for _, name := range []string{
+		"live/main.tf",
+		"live/a.generated.tf",
+		"live/examples/demo.tf",
+		"live/mod/prod.tfvars",
+		"live/mod/README.md",
+	} {
+		path := filepath.Join(repo, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte("# "+name+"\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeIgnoreFile(t, live, "*.generated.tf")
+
+	// Scanning the subdirectory merges its own .ops0ignore with the bound
+	// repo root's: the first drops a.generated.tf, the second examples/.
+	files, err := collectIacFiles(live)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, f := range files {
+		got = append(got, filepath.ToSlash(f.Name))
+	}
+	want := []string{"main.tf", "mod/prod.tfvars"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("dir scan: got %q, want %q", got, want)
+	}
+
+	// An explicit single-file target bypasses .ops0ignore.
+	files, err = collectIacFiles(filepath.Join(live, "a.generated.tf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0].Name != "a.generated.tf" {
+		t.Errorf("single-file scan: got %+v, want just a.generated.tf", files)
+	}
+}
@@ -39,8 +39,10 @@ Workflow:
 All Rego evaluation happens locally via OPA. Your code never leaves the machine
 — only check results (pass/fail counts, anonymized template IDs) are reported
 back to ops0 for audit telemetry, and only when you opt in.`,
-	SilenceUsage:  true,
-	SilenceErrors: true,
+	SilenceUsage:      true,
+	SilenceErrors:     true,
+	PersistentPreRun:  notifyIfUpdateAvailable,
+	PersistentPostRun: finishUpdateCheck,
 }
 
 func init() {
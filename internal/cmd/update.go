@@ -0,0 +1,244 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ops0-ai/ops0-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// Passive "new version available" notice.
+//
+// At most once a day we ask GitHub for the latest release in a background
+// goroutine and cache the answer in update-check.json. The notice itself is
+// printed from that cache on a later invocation, so the command the user
+// actually ran waits on the network for at most updateCheckWait, once a day.
+// Everything here is silent on failure — a flaky network or a GitHub rate
+// limit must never slow down or break a normal command.
+//
+// We only run when stderr is a terminal. Hook invocations (Stop, PreToolUse)
+// pipe stderr back to the agent, and an upgrade nag in the middle of a
+// validate report is noise the model doesn't need.
+
+const (
+	updateCheckURL      = "https://api.github.com/repos/ops0-ai/ops0-cli/releases/latest"
+	updateCheckInterval = 24 * time.Hour
+	updateCheckTimeout  = 2 * time.Second
+	updateCheckWait     = 500 * time.Millisecond
+)
+
+// updateCheckState is persisted next to the user config so the throttle
+// survives across invocations.
+type updateCheckState struct {
+	CheckedAt  time.Time `json:"checkedAt"`
+	Latest     string    `json:"latest,omitempty"`
+	NotifiedAt time.Time `json:"notifiedAt,omitempty"`
+}
+
+// pendingUpdateCheck is the in-flight fetch started by
+// notifyIfUpdateAvailable, collected by finishUpdateCheck. Nil when this
+// invocation didn't check.
+var pendingUpdateCheck *updateCheckFetch
+
+type updateCheckFetch struct {
+	path   string
+	state  updateCheckState
+	result chan string
+}
+
+// notifyIfUpdateAvailable is wired as the root PersistentPreRun.
+func notifyIfUpdateAvailable(cmd *cobra.Command, _ []string) {
+	if buildVersion == "dev" || os.Getenv("OPS0_NO_UPDATE_CHECK") != "" {
+		return
+	}
+	// `mcp serve` owns stdio for JSON-RPC, `telemetry` is hook-only,
+	// `version` output is often scraped by scripts, and the completion
+	// commands run on every TAB press.
+	switch cmd.Name() {
+	case "serve", "blocked-command", "version",
+		cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd, "completion":
+		return
+	}
+	if cmd.HasParent() && cmd.Parent().Name() == "completion" {
+		return
+	}
+	if !stderrIsTerminal() {
+		return
+	}
+
+	path, err := updateCheckPath()
+	if err != nil {
+		return
+	}
+	var state updateCheckState
+	if data, err := os.ReadFile(path); err == nil {
+		_ = json.Unmarshal(data, &state)
+	}
+
+	// Notice first, from whatever an earlier run cached. Throttled on its
+	// own clock so we nag at most once a day.
+	if state.Latest != "" && isNewerVersion(state.Latest, buildVersion) && time.Since(state.NotifiedAt) >= updateCheckInterval {
+		fmt.Fprintf(cmd.ErrOrStderr(), "A new ops0 release is available: %s (you have %s). Upgrade: curl -fsSL https://raw.githubusercontent.com/ops0-ai/ops0-cli/main/install.sh | sh\n",
+			strings.TrimPrefix(state.Latest, "v"), strings.TrimPrefix(buildVersion, "v"))
+		state.NotifiedAt = time.Now().UTC()
+		saveUpdateCheckState(path, state)
+	}
+
+	if time.Since(state.CheckedAt) < updateCheckInterval {
+		return
+	}
+	// Record the attempt before the fetch goes out, so the throttle holds
+	// even when the command exits first or GitHub is unreachable: at most
+	// one request a day, well clear of the unauthenticated rate limit.
+	state.CheckedAt = time.Now().UTC()
+	saveUpdateCheckState(path, state)
+
+	// The goroutine only fetches; finishUpdateCheck saves the answer from
+	// the main goroutine, so an exit mid-fetch can't strand a temp file.
+	fetch := &updateCheckFetch{path: path, state: state, result: make(chan string, 1)}
+	go func() {
+		latest, err := fetchLatestRelease()
+		if err != nil {
+			latest = ""
+		}
+		fetch.result <- latest
+	}()
+	pendingUpdateCheck = fetch
+}
+
+// finishUpdateCheck is wired as the root PersistentPostRun. It gives the
+// fetch started in notifyIfUpdateAvailable up to updateCheckWait to land,
+// then caches the answer for the next invocation to print.
+func finishUpdateCheck(_ *cobra.Command, _ []string) {
+	fetch := pendingUpdateCheck
+	if fetch == nil {
+		return
+	}
+	pendingUpdateCheck = nil
+	select {
+	case latest := <-fetch.result:
+		if latest != "" {
+			fetch.state.Latest = latest
+			saveUpdateCheckState(fetch.path, fetch.state)
+		}
+	case <-time.After(updateCheckWait):
+	}
+}
+
+// stderrIsTerminal is a dependency-free TTY check. A character device alone
+// isn't enough: /dev/null is one too, and scripts, cron and cobra's shell
+// completion all redirect stderr there.
+func stderrIsTerminal() bool {
+	info, err := os.Stderr.Stat()
+	if err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		return false
+	}
+	if null, err := os.Stat(os.DevNull); err == nil && os.SameFile(info, null) {
+		return false
+	}
+	return true
+}
+
+// saveUpdateCheckState writes via a temp file + rename so a concurrent ops0
+// process (or one killed mid-write) never sees a torn file.
+func saveUpdateCheckState(path string, s updateCheckState) {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".update-check-*")
+	if err != nil {
+		return
+	}
+	_, werr := tmp.Write(data)
+	cerr := tmp.Close()
+	if werr != nil || cerr != nil || os.Rename(tmp.Name(), path) != nil {
+		_ = os.Remove(tmp.Name())
+	}
+}
+
+// updateCheckPath lives alongside config.yaml (so it honours XDG_CONFIG_HOME
+// the same way).
+func updateCheckPath() (string, error) {
+	cfgPath, err := config.UserConfigPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(cfgPath), "update-check.json"), nil
+}
+
+// fetchLatestRelease returns the tag name of the latest GitHub release.
+func fetchLatestRelease() (string, error) {
+	client := &http.Client{Timeout: updateCheckTimeout}
+	req, err := http.NewRequest(http.MethodGet, updateCheckURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("User-Agent", "ops0-cli")
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("github returned %d", resp.StatusCode)
+	}
+	var out struct {
+		TagName string `json:"tag_name"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return "", err
+	}
+	return out.TagName, nil
+}
+
+// isNewerVersion compares dotted numeric versions ("v0.5.21" vs "0.5.9").
+// Pre-release / build suffixes are ignored; anything unparseable is treated
+// as "not newer" so a weird tag never produces a bogus notice.
+func isNewerVersion(latest, current string) bool {
+	parse := func(v string) ([]int, bool) {
+		v = strings.TrimPrefix(v, "v")
+		if i := strings.IndexAny(v, "-+"); i >= 0 {
+			v = v[:i]
+		}
+		parts := strings.Split(v, ".")
+		nums := make([]int, len(parts))
+		for i, p := range parts {
+			n, err := strconv.Atoi(p)
+			if err != nil {
+				return nil, false
+			}
+			nums[i] = n
+		}
+		return nums, true
+	}
+	l, ok1 := parse(latest)
+	c, ok2 := parse(current)
+	if !ok1 || !ok2 {
+		return false
+	}
+	for i := 0; i < len(l) || i < len(c); i++ {
+		var a, b int
+		if i < len(l) {
+			a = l[i]
+		}
+		if i < len(c) {
+			b = c[i]
+		}
+		if a != b {
+			return a > b
+		}
+	}
+	return false
+}
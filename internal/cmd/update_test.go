@@ -0,0 +1,43 @@
+package cmd
+
+import "testing"
+
+func TestIsNewerVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		latest  string
+		current string
+		want    bool
+	}{
+		{"patch bump", "0.5.10", "0.5.9", true},
+		{"numeric not lexical", "v0.5.21", "0.5.9", true},
+		{"minor bump", "v0.6.0", "v0.5.21", true},
+		{"major bump", "v1.0.0", "v0.99.99", true},
+		{"equal", "v0.5.9", "0.5.9", false},
+		{"older", "v0.5.8", "v0.5.9", false},
+
+		// Missing trailing segments count as zero.
+		{"shorter latest equal", "v0.6", "v0.6.0", false},
+		{"longer latest newer", "v0.6.0.1", "v0.6", true},
+		{"shorter latest older", "v0.5", "v0.5.1", false},
+
+		// Pre-release and build suffixes are dropped before comparing.
+		{"rc of same version", "v0.6.0-rc1", "v0.6.0", false},
+		{"rc of next version", "v0.6.0-rc1", "v0.5.9", true},
+		{"build metadata", "v0.5.9+abc", "v0.5.9", false},
+		{"current has suffix", "v0.5.10", "v0.5.9-dirty", true},
+
+		// Unparseable tags never nag.
+		{"garbage latest", "nightly", "v0.5.9", false},
+		{"empty latest", "", "v0.5.9", false},
+		{"garbage current", "v0.6.0", "dev", false},
+		{"empty segment", "v0..1", "v0.0.0", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isNewerVersion(tt.latest, tt.current); got != tt.want {
+				t.Errorf("isNewerVersion(%q, %q) = %v, want %v", tt.latest, tt.current, got, tt.want)
+			}
+		})
+	}
+}
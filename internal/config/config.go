@@ -14,6 +14,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"gopkg.in/yaml.v3"
 )
@@ -74,6 +75,9 @@ func LoadUser() (*UserConfig, error) {
 	if cfg.APIBaseURL == "" {
 		cfg.APIBaseURL = "https://brew.ops0.ai"
 	}
+	// A hand-edited quoted key can carry stray whitespace; strip it here so
+	// every caller sends the same header value `ops0 login` verified.
+	cfg.APIKey = strings.TrimSpace(cfg.APIKey)
 	return cfg, nil
 }
 